@@ -0,0 +1,219 @@
+// author: Gary A. Stafford
+// site: https://programmaticponderings.com
+// license: MIT License
+// purpose: JWT-based authentication and per-endpoint scope authorization for the RESTful API,
+//          with the original shared X-API-Key scheme retained as a fallback mode
+// modified: 2021-07-01
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/labstack/gommon/log"
+)
+
+// AuthMode selects how incoming requests are authenticated.
+type AuthMode string
+
+const (
+	AuthModeJWT    AuthMode = "jwt"     // Bearer-token JWT with per-route scopes.
+	AuthModeAPIKey AuthMode = "api-key" // Legacy shared X-API-Key header.
+)
+
+// Scopes understood by the authorization layer. A token carrying ScopeAdmin
+// satisfies every route regardless of its other roles.
+const (
+	ScopeTokensRead    = "tokens:read"
+	ScopeEntitiesRead  = "entities:read"
+	ScopeSentencesRead = "sentences:read"
+	ScopeAdmin         = "admin"
+)
+
+var (
+	authMode   = AuthMode(getEnv("AUTH_MODE", string(AuthModeJWT)))
+	jwtKeyFile = getEnv("JWT_KEY_FILE", "server.key")
+)
+
+// Claims are the custom JWT claims minted for API clients: a subject plus
+// the list of roles/scopes the token is authorized for.
+type Claims struct {
+	Roles []string `json:"roles"`
+	jwt.StandardClaims
+}
+
+// loadOrCreateServerKey reads the HMAC signing key from keyFile, generating
+// and persisting a new random key the first time the server runs.
+func loadOrCreateServerKey(keyFile string) ([]byte, error) {
+	if key, err := os.ReadFile(keyFile); err == nil {
+		return key, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+
+	key := []byte(hex.EncodeToString(raw))
+	if err := os.WriteFile(keyFile, key, 0600); err != nil {
+		return nil, err
+	}
+
+	log.Infof("generated new JWT signing key at %s", keyFile)
+	return key, nil
+}
+
+// mintToken signs a JWT for subject carrying roles using signingKey. The
+// token expires after ttl, enforced by jwt.StandardClaims.Valid() on parse.
+func mintToken(signingKey []byte, subject string, roles []string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		Roles: roles,
+		StandardClaims: jwt.StandardClaims{
+			Subject:   subject,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(ttl).Unix(),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(signingKey)
+}
+
+// hasScope reports whether claims grants the required scope, either
+// directly or via the blanket ScopeAdmin role.
+func hasScope(claims *Claims, scope string) bool {
+	for _, role := range claims.Roles {
+		if role == scope || role == ScopeAdmin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requireScope returns route middleware that rejects requests whose JWT
+// claims don't carry scope. In AuthModeAPIKey it is a no-op, since the
+// shared key has no notion of per-route scopes.
+func requireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if authMode == AuthModeAPIKey {
+				return next(c)
+			}
+
+			claims, ok := c.Get("claims").(*Claims)
+			if !ok {
+				return echo.NewHTTPError(http.StatusForbidden, "missing authentication claims")
+			}
+			if !hasScope(claims, scope) {
+				return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("token missing required scope %q", scope))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// isPublicRoute reports whether a request's path is exempt from
+// authentication, e.g. health checks and metrics scraping.
+func isPublicRoute(c echo.Context) bool {
+	uri := c.Request().RequestURI
+	return strings.HasPrefix(uri, "/health") || strings.HasPrefix(uri, "/metrics")
+}
+
+// jwtAuthMiddleware validates the "Authorization: Bearer <token>" header
+// against signingKey and stores the parsed claims on the Echo context for
+// requireScope to consult downstream.
+func jwtAuthMiddleware(signingKey []byte) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if isPublicRoute(c) {
+				return next(c)
+			}
+
+			raw := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+			if raw == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized, "missing bearer token")
+			}
+
+			claims := &Claims{}
+			_, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+				return signingKey, nil
+			})
+			if err != nil {
+				log.Errorf("jwt.ParseWithClaims Error: %v", err)
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid token")
+			}
+
+			c.Set("claims", claims)
+			return next(c)
+		}
+	}
+}
+
+// apiKeyAuthMiddleware is the original shared-secret scheme, kept available
+// as a fallback selected via AUTH_MODE=api-key.
+func apiKeyAuthMiddleware() echo.MiddlewareFunc {
+	return middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+		KeyLookup: "header:X-API-Key",
+		Skipper:   isPublicRoute,
+		Validator: func(key string, c echo.Context) (bool, error) {
+			log.Debugf("API_KEY: %v", apiKey)
+			return key == apiKey, nil
+		},
+	})
+}
+
+// runCreateToken implements the "server auth create-token" subcommand: it
+// mints a JWT signed with the server key (generating one on first use) for
+// the subject and roles given on the command line.
+func runCreateToken(args []string) error {
+	// Keep stdout token-only (so `TOKEN=$(... create-token ...)` works) by
+	// sending the key-generation notice from loadOrCreateServerKey to stderr.
+	log.SetOutput(os.Stderr)
+
+	fs := flag.NewFlagSet("create-token", flag.ExitOnError)
+	subject := fs.String("subject", "", "token subject, e.g. a client or service name")
+	roles := fs.String("roles", "", "comma-separated list of roles/scopes, e.g. tokens:read,entities:read")
+	ttl := fs.Duration("ttl", 24*time.Hour, "token lifetime, e.g. 24h")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *subject == "" {
+		return errors.New("-subject is required")
+	}
+
+	var roleList []string
+	for _, role := range strings.Split(*roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roleList = append(roleList, role)
+		}
+	}
+
+	key, err := loadOrCreateServerKey(jwtKeyFile)
+	if err != nil {
+		return err
+	}
+
+	token, err := mintToken(key, *subject, roleList, *ttl)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(token)
+	return nil
+}
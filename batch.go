@@ -0,0 +1,170 @@
+// author: Gary A. Stafford
+// site: https://programmaticponderings.com
+// license: MIT License
+// purpose: POST /batch endpoint for processing many documents per request, streaming
+//          NDJSON results back as a bounded worker pool finishes each one
+// modified: 2021-08-10
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// workerCount bounds how many documents /batch processes concurrently,
+// configurable via PROSE_WORKERS.
+var workerCount = func() int {
+	n, err := strconv.Atoi(getEnv("PROSE_WORKERS", "4"))
+	if err != nil || n < 1 {
+		return 4
+	}
+	return n
+}()
+
+// A BatchDoc is a single input document in a /batch request.
+type BatchDoc struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+// A BatchResult is one line of the streamed NDJSON /batch response.
+type BatchResult struct {
+	Document
+	ID    string `json:"id"`
+	Error string `json:"error,omitempty"`
+}
+
+// docOptsFromQuery builds a DocOpts from the ?extract=&segment=&tag=&tokenize=
+// query params, defaulting to the server's docOpts when none are given.
+func docOptsFromQuery(c echo.Context) DocOpts {
+	if len(c.QueryParams()) == 0 {
+		return docOpts
+	}
+
+	return DocOpts{
+		Extract:  c.QueryParam("extract") == "1",
+		Segment:  c.QueryParam("segment") == "1",
+		Tag:      c.QueryParam("tag") == "1",
+		Tokenize: c.QueryParam("tokenize") == "1",
+	}
+}
+
+// readBatchDocs reads the /batch request body as either a JSON array of
+// BatchDoc or newline-delimited JSON, based on the Content-Type header.
+func readBatchDocs(c echo.Context) ([]BatchDoc, error) {
+	if c.Request().Header.Get(echo.HeaderContentType) == "application/x-ndjson" {
+		var docs []BatchDoc
+		scanner := bufio.NewScanner(c.Request().Body)
+		for scanner.Scan() {
+			line := scanner.Bytes()
+			if len(line) == 0 {
+				continue
+			}
+
+			var doc BatchDoc
+			if err := json.Unmarshal(line, &doc); err != nil {
+				return nil, err
+			}
+			docs = append(docs, doc)
+		}
+
+		return docs, scanner.Err()
+	}
+
+	var docs []BatchDoc
+	if err := json.NewDecoder(c.Request().Body).Decode(&docs); err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+// analyzeBatchDoc runs analyze for a single batch document, folding any
+// failure into the result rather than aborting the whole batch.
+func analyzeBatchDoc(doc BatchDoc, opts DocOpts) BatchResult {
+	result := BatchResult{ID: doc.ID}
+
+	parsed, err := analyze(doc.Text, opts)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Document = *parsed
+	return result
+}
+
+// getBatch processes a JSON array or NDJSON stream of documents with a
+// bounded worker pool, streaming one NDJSON result line per document back
+// to the client as soon as it's ready.
+func getBatch(c echo.Context) error {
+	opts := docOptsFromQuery(c)
+
+	docs, err := readBatchDocs(c)
+	if err != nil {
+		log.Errorf("readBatchDocs Error: %v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// ctx is cancelled once the handler returns, for any reason (client
+	// disconnect, encode failure), so the feeder and workers below never
+	// block forever on a channel nobody is draining anymore.
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	jobs := make(chan BatchDoc)
+	results := make(chan BatchResult)
+
+	var workers sync.WaitGroup
+	for i := 0; i < workerCount; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for doc := range jobs {
+				select {
+				case results <- analyzeBatchDoc(doc, opts):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, doc := range docs {
+			select {
+			case jobs <- doc:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	c.Response().Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	c.Response().WriteHeader(http.StatusOK)
+
+	enc := json.NewEncoder(c.Response())
+	for result := range results {
+		if err := enc.Encode(result); err != nil {
+			log.Errorf("json.Encoder.Encode Error: %v", err)
+			return err
+		}
+		c.Response().Flush()
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// author: Gary A. Stafford
+// site: https://programmaticponderings.com
+// license: MIT License
+// purpose: content-type-driven request binding and response encoding for the analysis
+//          endpoints, supporting JSON, XML, form, and plain-text bodies
+// modified: 2021-09-05
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// An AnalyzeRequest is the input to the /tokens, /entities, and /sentences
+// endpoints: the text to analyze, bound from JSON, XML, or form bodies.
+type AnalyzeRequest struct {
+	Text string `json:"text" xml:"text" form:"text"`
+}
+
+// bindAnalyzeRequest binds an AnalyzeRequest from the request body. A
+// text/plain body is taken verbatim as the text field; JSON, XML
+// (application/xml or text/xml), and application/x-www-form-urlencoded are
+// bound via Echo's content-type-aware c.Bind. Any other or missing
+// Content-Type falls back to decoding the body as JSON, the same as the
+// original handlers did, rather than Echo's default 415 rejection.
+func bindAnalyzeRequest(c echo.Context) (AnalyzeRequest, error) {
+	var req AnalyzeRequest
+
+	contentType := c.Request().Header.Get(echo.HeaderContentType)
+	switch {
+	case strings.HasPrefix(contentType, echo.MIMETextPlain):
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return req, err
+		}
+
+		req.Text = string(body)
+		return req, nil
+	case strings.HasPrefix(contentType, echo.MIMEApplicationJSON),
+		strings.HasPrefix(contentType, echo.MIMEApplicationXML),
+		strings.HasPrefix(contentType, echo.MIMETextXML),
+		strings.HasPrefix(contentType, echo.MIMEApplicationForm):
+		err := c.Bind(&req)
+		return req, err
+	default:
+		err := json.NewDecoder(c.Request().Body).Decode(&req)
+		return req, err
+	}
+}
+
+// acceptsXML reports whether the client's Accept header lists an XML media
+// type (application/xml or text/xml), in which case XML is used instead of
+// the default JSON response encoding. Order and q-values are not weighed.
+func acceptsXML(c echo.Context) bool {
+	accept := c.Request().Header.Get(echo.HeaderAccept)
+	return strings.Contains(accept, echo.MIMEApplicationXML) || strings.Contains(accept, echo.MIMETextXML)
+}
+
+// respond writes body as XML or JSON depending on the request's Accept
+// header.
+func respond(c echo.Context, status int, body interface{}) error {
+	if acceptsXML(c) {
+		return c.XML(status, body)
+	}
+
+	return c.JSON(status, body)
+}
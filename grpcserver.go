@@ -0,0 +1,223 @@
+// author: Gary A. Stafford
+// site: https://programmaticponderings.com
+// license: MIT License
+// purpose: gRPC ProseService server sharing the REST API's analysis pipeline, with auth and
+//          recovery interceptors equivalent to the HTTP layer
+// modified: 2021-11-20
+
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+
+	prosev1 "github.com/garystafford/prose-app/proto/prose/v1"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/gommon/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcPort is the port the gRPC server listens on, alongside the Echo
+// REST API on serverPort.
+var grpcPort = getEnv("PROSE_GRPC_PORT", "9090")
+
+// grpcMethodScopes maps each RPC to the scope required to call it, mirroring
+// the route-to-scope mapping used by the REST API's requireScope middleware.
+var grpcMethodScopes = map[string]string{
+	"/prose.v1.ProseService/Tokenize":        ScopeTokensRead,
+	"/prose.v1.ProseService/ExtractEntities": ScopeEntitiesRead,
+	"/prose.v1.ProseService/Segment":         ScopeSentencesRead,
+	"/prose.v1.ProseService/Analyze":         ScopeAdmin,
+}
+
+// runGRPC starts the gRPC server on grpcPort, sharing analysisPipeline with
+// the REST handlers. signingKey is only consulted in AuthModeJWT.
+func runGRPC(signingKey []byte) error {
+	lis, err := net.Listen("tcp", ":"+grpcPort)
+	if err != nil {
+		return err
+	}
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcRecoveryUnaryInterceptor, grpcAuthUnaryInterceptor(signingKey)),
+		grpc.ChainStreamInterceptor(grpcRecoveryStreamInterceptor, grpcAuthStreamInterceptor(signingKey)),
+	)
+	prosev1.RegisterProseServiceServer(srv, proseServiceServer{})
+
+	log.Infof("gRPC server started on port %s", grpcPort)
+	return srv.Serve(lis)
+}
+
+// authenticateGRPC validates the credential carried in ctx's metadata
+// ("x-api-key" in AuthModeAPIKey, "authorization: Bearer <token>" in
+// AuthModeJWT) and, for JWT, checks the token against the scope required by
+// fullMethod.
+func authenticateGRPC(ctx context.Context, signingKey []byte, fullMethod string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	if authMode == AuthModeAPIKey {
+		keys := md.Get("x-api-key")
+		if len(keys) == 0 || keys[0] != apiKey {
+			return status.Error(codes.Unauthenticated, "invalid API key")
+		}
+		return nil
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	raw := strings.TrimPrefix(values[0], "Bearer ")
+	claims := &Claims{}
+	if _, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		return signingKey, nil
+	}); err != nil {
+		log.Errorf("jwt.ParseWithClaims Error: %v", err)
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	if scope, ok := grpcMethodScopes[fullMethod]; ok && !hasScope(claims, scope) {
+		return status.Errorf(codes.PermissionDenied, "token missing required scope %q", scope)
+	}
+
+	return nil
+}
+
+// grpcAuthUnaryInterceptor enforces authenticateGRPC on unary RPCs.
+func grpcAuthUnaryInterceptor(signingKey []byte) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authenticateGRPC(ctx, signingKey, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// grpcAuthStreamInterceptor enforces authenticateGRPC on streaming RPCs.
+func grpcAuthStreamInterceptor(signingKey []byte) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authenticateGRPC(ss.Context(), signingKey, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// grpcRecoveryUnaryInterceptor turns a panic in a unary handler into an
+// Internal status error instead of crashing the server.
+func grpcRecoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("grpc panic recovered in %s: %v", info.FullMethod, r)
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// grpcRecoveryStreamInterceptor is grpcRecoveryUnaryInterceptor for
+// streaming RPCs.
+func grpcRecoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("grpc panic recovered in %s: %v", info.FullMethod, r)
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// proseServiceServer implements prosev1.ProseServiceServer against the
+// shared analysisPipeline.
+type proseServiceServer struct{}
+
+func (proseServiceServer) Tokenize(_ context.Context, req *prosev1.TokenizeRequest) (*prosev1.TokenizeResponse, error) {
+	doc, err := analyze(req.Text, DocOpts{Tokenize: true, Tag: true})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &prosev1.TokenizeResponse{Tokens: toProtoTokens(doc.Tokens)}, nil
+}
+
+func (proseServiceServer) ExtractEntities(_ context.Context, req *prosev1.ExtractEntitiesRequest) (*prosev1.ExtractEntitiesResponse, error) {
+	doc, err := analyze(req.Text, DocOpts{Extract: true})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &prosev1.ExtractEntitiesResponse{Entities: toProtoEntities(doc.Entities)}, nil
+}
+
+func (proseServiceServer) Segment(_ context.Context, req *prosev1.SegmentRequest) (*prosev1.SegmentResponse, error) {
+	doc, err := analyze(req.Text, DocOpts{Segment: true})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &prosev1.SegmentResponse{Sentences: toProtoSentences(doc.Sentences)}, nil
+}
+
+func (proseServiceServer) Analyze(stream prosev1.ProseService_AnalyzeServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp := &prosev1.AnalyzeResponse{Id: req.Id}
+		doc, err := analyze(req.Text, DocOpts{
+			Extract:  req.Extract,
+			Segment:  req.Segment,
+			Tag:      req.Tag,
+			Tokenize: req.Tokenize,
+		})
+		if err != nil {
+			resp.Error = err.Error()
+		} else {
+			resp.Tokens = toProtoTokens(doc.Tokens)
+			resp.Entities = toProtoEntities(doc.Entities)
+			resp.Sentences = toProtoSentences(doc.Sentences)
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func toProtoTokens(tokens []Token) []*prosev1.Token {
+	out := make([]*prosev1.Token, 0, len(tokens))
+	for _, t := range tokens {
+		out = append(out, &prosev1.Token{Tag: t.Tag, Text: t.Text, Label: t.Label})
+	}
+	return out
+}
+
+func toProtoEntities(entities []Entity) []*prosev1.Entity {
+	out := make([]*prosev1.Entity, 0, len(entities))
+	for _, e := range entities {
+		out = append(out, &prosev1.Entity{Text: e.Text, Label: e.Label})
+	}
+	return out
+}
+
+func toProtoSentences(sentences []Sentence) []*prosev1.Sentence {
+	out := make([]*prosev1.Sentence, 0, len(sentences))
+	for _, s := range sentences {
+		out = append(out, &prosev1.Sentence{Text: s.Text})
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package pipeline
+
+import "container/list"
+
+// lru is a bounded cache of *Result keyed by string, evicting the
+// least-recently-used entry once either the entry count or the total
+// cached text size exceeds its configured bound. A bound of 0 disables
+// that particular limit. Callers must hold their own lock; lru isn't
+// safe for concurrent use on its own.
+type lru struct {
+	maxEntries int
+	maxBytes   int64
+	curBytes   int64
+
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type lruEntry struct {
+	key   string
+	value *Result
+	bytes int
+}
+
+func newLRU(maxEntries int, maxBytes int64) *lru {
+	return &lru{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+func (c *lru) get(key string) (*Result, bool) {
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return el.Value.(*lruEntry).value, true
+}
+
+func (c *lru) put(key string, value *Result, size int) {
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		entry := el.Value.(*lruEntry)
+		c.curBytes += int64(size - entry.bytes)
+		entry.value, entry.bytes = value, size
+		c.evict()
+		return
+	}
+
+	el := c.ll.PushFront(&lruEntry{key: key, value: value, bytes: size})
+	c.items[key] = el
+	c.curBytes += int64(size)
+	c.evict()
+}
+
+func (c *lru) evict() {
+	for (c.maxEntries > 0 && c.ll.Len() > c.maxEntries) || (c.maxBytes > 0 && c.curBytes > c.maxBytes) {
+		back := c.ll.Back()
+		if back == nil {
+			return
+		}
+
+		entry := back.Value.(*lruEntry)
+		c.ll.Remove(back)
+		delete(c.items, entry.key)
+		c.curBytes -= int64(entry.bytes)
+	}
+}
@@ -0,0 +1,151 @@
+// author: Gary A. Stafford
+// site: https://programmaticponderings.com
+// license: MIT License
+// purpose: reusable prose.Document pipeline that builds the tagger/extracter model once
+//          and caches assembled results, instead of reloading models on every request
+// modified: 2021-10-12
+
+// Package pipeline wraps github.com/jdkato/prose/v2 with a model that's
+// built once at startup and a content-hash-keyed LRU cache of assembled
+// results, so repeat requests avoid re-tokenizing identical text.
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jdkato/prose/v2"
+)
+
+// Opts selects which parts of the analysis to run.
+type Opts struct {
+	Extract  bool // If true, include named-entity extraction
+	Segment  bool // If true, include segmentation
+	Tag      bool // If true, include POS tagging
+	Tokenize bool // If true, include tokenization
+}
+
+// A Token is a single word or punctuation symbol produced by tokenization.
+type Token struct {
+	Tag   string
+	Text  string
+	Label string
+}
+
+// An Entity is a single named-entity produced by extraction.
+type Entity struct {
+	Text  string
+	Label string
+}
+
+// A Sentence is a single sentence produced by segmentation.
+type Sentence struct {
+	Text string
+}
+
+// A Result is the assembled output of a single Analyze call.
+type Result struct {
+	Tokens    []Token
+	Entities  []Entity
+	Sentences []Sentence
+}
+
+// A Pipeline builds the prose tagger/extracter model once and reuses it
+// across Analyze calls, backed by a bounded LRU cache of assembled Results.
+type Pipeline struct {
+	model *prose.Model
+
+	mu    sync.Mutex
+	cache *lru
+
+	hits, misses uint64
+}
+
+// New builds a Pipeline, warming the prose model once up front and bounding
+// the result cache to maxEntries entries and maxBytes of cached text.
+func New(maxEntries int, maxBytes int64) (*Pipeline, error) {
+	warm, err := prose.NewDocument("Warming up the model.",
+		prose.WithTagging(true), prose.WithExtraction(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pipeline{
+		model: warm.Model,
+		cache: newLRU(maxEntries, maxBytes),
+	}, nil
+}
+
+// Analyze runs the pipeline's prose model against text, collecting the
+// pieces selected by opts into a Result. Results are cached by a hash of
+// (text, opts), so identical requests skip re-tokenization.
+func (p *Pipeline) Analyze(text string, opts Opts) (*Result, error) {
+	key := cacheKey(text, opts)
+
+	p.mu.Lock()
+	if result, ok := p.cache.get(key); ok {
+		p.mu.Unlock()
+		atomic.AddUint64(&p.hits, 1)
+		return result, nil
+	}
+	p.mu.Unlock()
+	atomic.AddUint64(&p.misses, 1)
+
+	doc, err := prose.NewDocument(text,
+		prose.UsingModel(p.model),
+		prose.WithTokenization(opts.Tokenize || opts.Tag),
+		prose.WithTagging(opts.Tag),
+		prose.WithSegmentation(opts.Segment),
+		prose.WithExtraction(opts.Extract),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	if opts.Tokenize || opts.Tag {
+		for _, token := range doc.Tokens() {
+			result.Tokens = append(result.Tokens, Token{
+				Tag:   token.Tag,
+				Text:  token.Text,
+				Label: token.Label,
+			})
+		}
+	}
+	if opts.Extract {
+		for _, entity := range doc.Entities() {
+			result.Entities = append(result.Entities, Entity{
+				Text:  entity.Text,
+				Label: entity.Label,
+			})
+		}
+	}
+	if opts.Segment {
+		for _, sentence := range doc.Sentences() {
+			result.Sentences = append(result.Sentences, Sentence{Text: sentence.Text})
+		}
+	}
+
+	p.mu.Lock()
+	p.cache.put(key, result, len(text))
+	p.mu.Unlock()
+
+	return result, nil
+}
+
+// Stats reports the pipeline's cumulative cache hit/miss counts.
+func (p *Pipeline) Stats() (hits, misses uint64) {
+	return atomic.LoadUint64(&p.hits), atomic.LoadUint64(&p.misses)
+}
+
+// cacheKey hashes text together with opts so differing option combinations
+// for the same text don't collide.
+func cacheKey(text string, opts Opts) string {
+	h := sha256.New()
+	h.Write([]byte(text))
+	fmt.Fprintf(h, "%+v", opts)
+	return hex.EncodeToString(h.Sum(nil))
+}
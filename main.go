@@ -4,39 +4,58 @@
 // purpose: RESTful Go implementation of github.com/jdkato/prose/v2 package
 //          for text processing, including tokenization, part-of-speech tagging, and named-entity extraction
 //          by https://github.com/jdkato/prose/tree/v2
-// modified: 2021-06-13
+// modified: 2021-07-01
 
 package main
 
 import (
 	"encoding/json"
-	"github.com/jdkato/prose/v2"
+	"encoding/xml"
+	"fmt"
+	"github.com/garystafford/prose-app/internal/pipeline"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/labstack/gommon/log"
 	"net/http"
 	"os"
 	"strconv"
-	"strings"
 )
 
 // A Token represents an individual Token of Text such as a word or punctuation symbol.
 // IOB format (short for inside, outside, beginning) is a common tagging format
 type Token struct {
-	Tag   string `json:"tag"`   // The Token's part-of-speech Tag.
-	Text  string `json:"text"`  // The Token's actual content.
-	Label string `json:"label"` // The Token's IOB Label.
+	Tag   string `json:"tag" xml:"tag"`     // The Token's part-of-speech Tag.
+	Text  string `json:"text" xml:"text"`   // The Token's actual content.
+	Label string `json:"label" xml:"label"` // The Token's IOB Label.
 }
 
 // An Entity represents an individual named-entity.
 type Entity struct {
-	Text  string `json:"text"`  // The entity's actual content.
-	Label string `json:"label"` // The entity's label.
+	Text  string `json:"text" xml:"text"`   // The entity's actual content.
+	Label string `json:"label" xml:"label"` // The entity's label.
 }
 
 // A Sentence represents a doc's sentence.
 type Sentence struct {
-	Text string `json:"text"` // The sentences.
+	Text string `json:"text" xml:"text"` // The sentences.
+}
+
+// TokensDoc, EntitiesDoc, and SentencesDoc wrap their respective lists in a
+// single root element so they can be marshalled as well-formed XML; as JSON
+// they serialize as {"tokens": [...]}, etc.
+type TokensDoc struct {
+	XMLName xml.Name `xml:"tokens" json:"-"`
+	Tokens  []Token  `xml:"token" json:"tokens"`
+}
+
+type EntitiesDoc struct {
+	XMLName  xml.Name `xml:"entities" json:"-"`
+	Entities []Entity `xml:"entity" json:"entities"`
+}
+
+type SentencesDoc struct {
+	XMLName   xml.Name   `xml:"sentences" json:"-"`
+	Sentences []Sentence `xml:"sentence" json:"sentences"`
 }
 
 type DocOpts struct {
@@ -46,6 +65,14 @@ type DocOpts struct {
 	Tokenize bool // If true, include tokenization
 }
 
+// A Document bundles the pieces of analysis selected by a DocOpts, shared
+// by the single-document handlers and the /batch endpoint.
+type Document struct {
+	Tokens    []Token    `json:"tokens,omitempty"`
+	Entities  []Entity   `json:"entities,omitempty"`
+	Sentences []Sentence `json:"sentences,omitempty"`
+}
+
 var (
 	logLevel   = getEnv("LOG_LEVEL", "1") // INFO
 	serverPort = getEnv("PROSE_PORT", "8080")
@@ -57,8 +84,30 @@ var (
 		Tag:      true,
 		Tokenize: true,
 	}
+
+	// analysisPipeline is built once in run(), reusing its prose model and
+	// result cache across every request.
+	analysisPipeline *pipeline.Pipeline
 )
 
+// cacheMaxEntries and cacheMaxBytes bound the pipeline's result cache,
+// configurable via PROSE_CACHE_ENTRIES and PROSE_CACHE_BYTES.
+var cacheMaxEntries = func() int {
+	n, err := strconv.Atoi(getEnv("PROSE_CACHE_ENTRIES", "1000"))
+	if err != nil || n < 0 {
+		return 1000
+	}
+	return n
+}()
+
+var cacheMaxBytes = func() int64 {
+	n, err := strconv.ParseInt(getEnv("PROSE_CACHE_BYTES", "10485760"), 10, 64)
+	if err != nil || n < 0 {
+		return 10485760
+	}
+	return n
+}()
+
 func getEnv(key, fallback string) string {
 	if value, ok := os.LookupEnv(key); ok {
 		return value
@@ -78,82 +127,89 @@ func getHealth(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// analyze runs text through the shared analysisPipeline and collects the
+// pieces selected by opts into a Document.
+func analyze(text string, opts DocOpts) (*Document, error) {
+	result, err := analysisPipeline.Analyze(text, pipeline.Opts{
+		Extract:  opts.Extract,
+		Segment:  opts.Segment,
+		Tag:      opts.Tag,
+		Tokenize: opts.Tokenize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{}
+
+	for _, token := range result.Tokens {
+		doc.Tokens = append(doc.Tokens, Token{
+			Tag:   token.Tag,
+			Text:  token.Text,
+			Label: token.Label,
+		})
+	}
+
+	for _, entity := range result.Entities {
+		doc.Entities = append(doc.Entities, Entity{
+			Text:  entity.Text,
+			Label: entity.Label,
+		})
+	}
+
+	for _, sentence := range result.Sentences {
+		doc.Sentences = append(doc.Sentences, Sentence{Text: sentence.Text})
+	}
+
+	return doc, nil
+}
+
 func getTokens(c echo.Context) error {
-	var tokens []Token
-	jsonMap := make(map[string]interface{})
-	err := json.NewDecoder(c.Request().Body).Decode(&jsonMap)
+	req, err := bindAnalyzeRequest(c)
 	if err != nil {
-		log.Errorf("json.NewDecoder Error: %v", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, err)
-	} else {
-		text := jsonMap["text"]
-		doc, err := prose.NewDocument(text.(string))
-		if err != nil {
-			log.Errorf("prose.NewDocument Error: %v", err)
-			return echo.NewHTTPError(http.StatusInternalServerError, err)
-		}
+		log.Errorf("bindAnalyzeRequest Error: %v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
 
-		for _, docToken := range doc.Tokens() {
-			tokens = append(tokens, Token{
-				Tag:   docToken.Tag,
-				Text:  docToken.Text,
-				Label: docToken.Label,
-			})
-		}
+	doc, err := analyze(req.Text, DocOpts{Tokenize: true, Tag: true})
+	if err != nil {
+		log.Errorf("analyze Error: %v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusOK, tokens)
+	return respond(c, http.StatusOK, TokensDoc{Tokens: doc.Tokens})
 }
 
 func getEntities(c echo.Context) error {
-	var entities []Entity
-	jsonMap := make(map[string]interface{})
-	err := json.NewDecoder(c.Request().Body).Decode(&jsonMap)
+	req, err := bindAnalyzeRequest(c)
 	if err != nil {
-		log.Errorf("json.NewDecoder Error: %v", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, err)
-	} else {
-		text := jsonMap["text"]
-		doc, err := prose.NewDocument(text.(string))
-		if err != nil {
-			log.Errorf("prose.NewDocument Error: %v", err)
-			return c.JSON(http.StatusInternalServerError, err)
-		}
+		log.Errorf("bindAnalyzeRequest Error: %v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
 
-		for _, docEntities := range doc.Entities() {
-			entities = append(entities, Entity{
-				Text:  docEntities.Text,
-				Label: docEntities.Label,
-			})
-		}
+	doc, err := analyze(req.Text, DocOpts{Extract: true})
+	if err != nil {
+		log.Errorf("analyze Error: %v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusOK, entities)
+	return respond(c, http.StatusOK, EntitiesDoc{Entities: doc.Entities})
 }
 
 func getSentences(c echo.Context) error {
-	var sentences []Sentence
-	jsonMap := make(map[string]interface{})
-	err := json.NewDecoder(c.Request().Body).Decode(&jsonMap)
+	req, err := bindAnalyzeRequest(c)
 	if err != nil {
-		log.Errorf("json.NewDecoder Error: %v", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, err)
-	} else {
-		text := jsonMap["text"]
-		doc, err := prose.NewDocument(text.(string))
-		if err != nil {
-			log.Errorf("prose.NewDocument Error: %v", err)
-			return c.JSON(http.StatusInternalServerError, err)
-		}
+		log.Errorf("bindAnalyzeRequest Error: %v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
 
-		for _, docEntities := range doc.Sentences() {
-			sentences = append(sentences, Sentence{
-				Text: docEntities.Text,
-			})
-		}
+	doc, err := analyze(req.Text, DocOpts{Segment: true})
+	if err != nil {
+		log.Errorf("analyze Error: %v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusOK, sentences)
+	return respond(c, http.StatusOK, SentencesDoc{Sentences: doc.Sentences})
 }
 
 func run() error {
@@ -161,25 +217,38 @@ func run() error {
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
 
-	e.Use(middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
-		KeyLookup: "header:X-API-Key",
-		Skipper: func(c echo.Context) bool {
-			if strings.HasPrefix(c.Request().RequestURI, "/health") {
-				return true
-			}
-			return false
-		},
-		Validator: func(key string, c echo.Context) (bool, error) {
-			log.Debugf("API_KEY: %v", apiKey)
-			return key == apiKey, nil
-		},
-	}))
+	pl, err := pipeline.New(cacheMaxEntries, cacheMaxBytes)
+	if err != nil {
+		return err
+	}
+	analysisPipeline = pl
+	registerMetrics()
+
+	var signingKey []byte
+	switch authMode {
+	case AuthModeAPIKey:
+		e.Use(apiKeyAuthMiddleware())
+	default:
+		signingKey, err = loadOrCreateServerKey(jwtKeyFile)
+		if err != nil {
+			return err
+		}
+		e.Use(jwtAuthMiddleware(signingKey))
+	}
 
 	// Routes
 	e.GET("/health", getHealth)
-	e.POST("/tokens", getTokens)
-	e.POST("/entities", getEntities)
-	e.POST("/sentences", getSentences)
+	e.POST("/tokens", getTokens, requireScope(ScopeTokensRead))
+	e.POST("/entities", getEntities, requireScope(ScopeEntitiesRead))
+	e.POST("/sentences", getSentences, requireScope(ScopeSentencesRead))
+	e.POST("/batch", getBatch, requireScope(ScopeAdmin))
+
+	// The gRPC server shares analysisPipeline and runs alongside the REST API.
+	go func() {
+		if err := runGRPC(signingKey); err != nil {
+			e.Logger.Errorf("runGRPC Error: %v", err)
+		}
+	}()
 
 	// Start server
 	return e.Start(serverPort)
@@ -191,8 +260,17 @@ func init() {
 }
 
 func main() {
+	args := os.Args[1:]
+	if len(args) >= 3 && args[0] == "server" && args[1] == "auth" && args[2] == "create-token" {
+		if err := runCreateToken(args[3:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := run(); err != nil {
 		e.Logger.Fatal(err)
 		os.Exit(1)
 	}
-}
\ No newline at end of file
+}
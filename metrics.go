@@ -0,0 +1,39 @@
+// author: Gary A. Stafford
+// site: https://programmaticponderings.com
+// license: MIT License
+// purpose: Prometheus /metrics endpoint exposing the analysis pipeline's cache hit/miss counters
+// modified: 2021-10-12
+
+package main
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	cacheHitsMetric = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "prose_pipeline_cache_hits_total",
+		Help: "Cumulative number of analysis pipeline cache hits.",
+	}, func() float64 {
+		hits, _ := analysisPipeline.Stats()
+		return float64(hits)
+	})
+
+	cacheMissesMetric = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "prose_pipeline_cache_misses_total",
+		Help: "Cumulative number of analysis pipeline cache misses.",
+	}, func() float64 {
+		_, misses := analysisPipeline.Stats()
+		return float64(misses)
+	})
+)
+
+// registerMetrics registers the pipeline cache counters with the default
+// Prometheus registry and mounts /metrics. Must run after analysisPipeline
+// is assigned.
+func registerMetrics() {
+	prometheus.MustRegister(cacheHitsMetric, cacheMissesMetric)
+	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+}